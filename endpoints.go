@@ -0,0 +1,48 @@
+package pool
+
+import "context"
+
+// Endpoint is one candidate backend a multi-endpoint pool can dial.
+// Priority orders candidates when the pool spreads connections across them -
+// lower values are preferred, mirroring how Redis sentinel/replica priority
+// works.
+type Endpoint struct {
+	Addr     string
+	Priority int
+}
+
+// EndpointProvider supplies the set of candidate endpoints a pool should
+// dial, and optionally streams updates as that set changes - failover,
+// sentinel reconfiguration, scaling events, and so on.
+type EndpointProvider interface {
+	Endpoints(ctx context.Context) ([]Endpoint, error)
+	// Watch is optional - a provider that has nothing to stream can return a
+	// nil channel and a nil error, and the pool will stick with whatever
+	// Endpoints returned at startup.
+	Watch(ctx context.Context) (<-chan []Endpoint, error)
+}
+
+// ConnectToFunc dials a connection to a specific Endpoint, as an alternative
+// to ConnectFunc for pools configured with Endpoints.
+type ConnectToFunc[T Connection] func(Endpoint) (T, error)
+
+// staticEndpoints is the trivial EndpointProvider for a fixed set of
+// backends that never changes.
+type staticEndpoints struct {
+	endpoints []Endpoint
+}
+
+// StaticEndpoints returns an EndpointProvider for a fixed set of endpoints
+// that never changes - the common case when failover is handled by DNS or a
+// load balancer rather than the pool itself.
+func StaticEndpoints(endpoints []Endpoint) EndpointProvider {
+	return &staticEndpoints{endpoints: endpoints}
+}
+
+func (s *staticEndpoints) Endpoints(ctx context.Context) ([]Endpoint, error) {
+	return s.endpoints, nil
+}
+
+func (s *staticEndpoints) Watch(ctx context.Context) (<-chan []Endpoint, error) {
+	return nil, nil
+}
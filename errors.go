@@ -6,5 +6,9 @@ var (
 	ErrNoConnectFunc          = errors.New("no connect function specified")
 	ErrNoHealthyFunc          = errors.New("no health function specified")
 	ErrInvalidConnectionCount = errors.New("invalid connection count")
+	ErrInvalidMaxConnections  = errors.New("max connections must be greater than or equal to min connections")
 	ErrInvalidProbeInterval   = errors.New("invalid probe interval")
+	ErrPoolTimeout            = errors.New("timed out waiting for a connection")
+	ErrPoolClosed             = errors.New("pool is closed")
+	ErrNoHealthyEndpoints     = errors.New("no healthy endpoints to connect to")
 )
@@ -0,0 +1,102 @@
+package pool
+
+import (
+	"sync"
+	"time"
+)
+
+// PoolEventType identifies what happened in a PoolEvent.
+type PoolEventType int
+
+const (
+	// PoolEventCreated is emitted when a new connection is successfully dialled
+	PoolEventCreated PoolEventType = iota
+	// PoolEventConnectFailed is emitted when dialling a new connection returns an error
+	PoolEventConnectFailed
+	// PoolEventCloseFailed is emitted when closing a connection returns an error
+	PoolEventCloseFailed
+	// PoolEventCheckedOut is emitted when a connection is handed out via Next/ExNext/Acquire/AcquireExclusive
+	PoolEventCheckedOut
+	// PoolEventCheckedIn is emitted when a connection is handed back via Return
+	PoolEventCheckedIn
+	// PoolEventRemoved is emitted when a connection is closed and dropped from the pool
+	PoolEventRemoved
+	// PoolEventCleared is emitted when the pool is closed or cleared via Clear
+	PoolEventCleared
+	// PoolEventProbeStart is emitted at the start of every probe() run
+	PoolEventProbeStart
+	// PoolEventProbeEnd is emitted at the end of every probe() run
+	PoolEventProbeEnd
+	// PoolEventInterruption is emitted when a checked-out connection is
+	// force-closed before the caller returned it - by Clear(true), or because
+	// its endpoint was removed or failed a Healthy check
+	PoolEventInterruption
+)
+
+// PoolEvent describes something that happened to a connection or the pool as a whole.
+type PoolEvent struct {
+	Type PoolEventType
+	// Reason gives extra context for Removed/CloseFailed events, e.g. "unhealthy", "stale", "idle"
+	Reason    string
+	Err       error
+	Timestamp time.Time
+}
+
+// EventMonitor is a hook for observing pool lifecycle events. It composes
+// with, but is independent of, PoolMetrics - useful for tracing spans,
+// structured logs, or test assertions on pool behaviour.
+type EventMonitor interface {
+	Event(PoolEvent)
+}
+
+// AsyncEventMonitor wraps an EventMonitor so events are delivered from a
+// single background goroutine instead of inline on the calling goroutine.
+// Events are dropped, rather than blocking the pool, if the buffer fills up.
+type AsyncEventMonitor struct {
+	monitor EventMonitor
+	events  chan PoolEvent
+
+	closer *sync.Once
+	closed chan struct{}
+}
+
+// NewAsyncEventMonitor starts a goroutine that delivers events to monitor
+// one at a time, buffering up to size events before new ones are dropped.
+func NewAsyncEventMonitor(monitor EventMonitor, size int) *AsyncEventMonitor {
+	m := &AsyncEventMonitor{
+		monitor: monitor,
+		events:  make(chan PoolEvent, size),
+		closer:  &sync.Once{},
+		closed:  make(chan struct{}),
+	}
+
+	go m.run()
+
+	return m
+}
+
+func (m *AsyncEventMonitor) Event(e PoolEvent) {
+	select {
+	case m.events <- e:
+	default:
+	}
+}
+
+// Close stops the delivery goroutine. Buffered events that haven't been
+// delivered yet are dropped.
+func (m *AsyncEventMonitor) Close() {
+	m.closer.Do(func() {
+		close(m.closed)
+	})
+}
+
+func (m *AsyncEventMonitor) run() {
+	for {
+		select {
+		case e := <-m.events:
+			m.monitor.Event(e)
+		case <-m.closed:
+			return
+		}
+	}
+}
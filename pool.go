@@ -1,6 +1,10 @@
 package pool
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -8,20 +12,73 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// Connection is anything the pool can dial and hand out. Concrete
+// connection types should be pointers (or another reference-like kind - see
+// connKey) so the pool can track them through ExNext/Return without
+// requiring T to be comparable.
 type Connection interface {
 	Close() error
 }
 
+// connKey derives a stable identity for c so the pool can find its
+// bookkeeping entry when it's handed back via Return, without requiring
+// every concrete Connection type to be comparable. It only works for
+// reference-like kinds (pointer, chan, func, map, slice, unsafe pointer),
+// which covers every realistic connection type - concrete connections are
+// almost always pointers.
+func connKey[T Connection](c T) uintptr {
+	v := reflect.ValueOf(c)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Chan, reflect.Func, reflect.Map, reflect.Slice, reflect.UnsafePointer:
+		return v.Pointer()
+	default:
+		panic("pool: Connection must be a pointer or other reference type to be used with ExNext/Return")
+	}
+}
+
 type ConnectFunc[T Connection] func() (T, error)
 type HealthyFunc[T Connection] func(T) bool
 
 type PoolMetrics struct {
-	// The number of connections currently in the pool
+	// The number of connections currently in the pool (idle + in-use)
+	//
+	// Deprecated: use Total instead
 	Connections prometheus.Gauge
 	// The number of errors when connecting
 	ConnectionErrors prometheus.Counter
 	// The number of connections retrieved from the pool
 	Retrievals prometheus.Counter
+	// The number of connections currently checked out via ExNext
+	InUse prometheus.Gauge
+	// The number of idle connections sat in the pool, ready to be handed out
+	Idle prometheus.Gauge
+	// The total number of connections the pool currently holds open, idle + in-use
+	Total prometheus.Gauge
+	// The number of callers to Acquire/AcquireExclusive that timed out waiting
+	WaitTimeouts prometheus.Counter
+	// The time callers to Acquire/AcquireExclusive spent waiting for a connection
+	WaitDurationSeconds prometheus.Histogram
+	// The number of connections closed by probe() for exceeding MaxConnLifetime/MaxConnIdleTime
+	StaleClosures prometheus.Counter
+}
+
+// entry is the internal representation of a pooled connection, tracking
+// bookkeeping that T itself can't carry for us.
+type entry[T Connection] struct {
+	conn T
+	// createdAt is when the connection was dialled
+	createdAt time.Time
+	// lastUsedAt is the time the connection was last handed out or returned
+	lastUsedAt time.Time
+	// generation is the pool's generation counter at the time this
+	// connection was dialled, used by Clear to identify stale connections
+	generation uint64
+	// endpoint is the backend this connection was dialled against, set only
+	// when the pool is configured with Endpoints/ConnectTo
+	endpoint Endpoint
+	// id is a monotonically assigned identifier used to track this
+	// connection in checkedOut/interrupted without requiring T to be comparable
+	id uint64
 }
 
 type PoolOptions[T Connection] struct {
@@ -29,24 +86,75 @@ type PoolOptions[T Connection] struct {
 	Connect ConnectFunc[T]
 	// AN optional function that is used to check the health of the connection
 	Healthy HealthyFunc[T]
+
+	// Endpoints is an alternative to Connect for pools that should spread
+	// connections across, and fail over between, multiple candidate
+	// backends - e.g. a Redis sentinel-discovered primary/replica set.
+	// Requires ConnectTo to also be set
+	Endpoints EndpointProvider
+	// ConnectTo dials a connection to a specific Endpoint. Used instead of
+	// Connect when Endpoints is set
+	ConnectTo ConnectToFunc[T]
+
 	// The number of connections in the pool
+	//
+	// Deprecated: use MinConnections/MaxConnections instead. If those are
+	// left unset, Connections is used as both the min and the max, giving
+	// the original fixed-size behaviour.
 	Connections int
+
+	// MinConnections is the baseline number of connections probe() keeps
+	// warm in the pool
+	MinConnections int
+	// MaxConnections is the hard cap on the number of connections the pool
+	// will open, including ones dialled on demand by Next()/ExNext()
+	MaxConnections int
+	// IdleTimeout is how long a connection can sit idle above
+	// MinConnections before probe() closes it. Zero disables idle reaping
+	IdleTimeout time.Duration
+	// MaxConnLifetime is how long a connection can live, idle or not, before
+	// probe() closes it as stale. Zero disables lifetime reaping
+	MaxConnLifetime time.Duration
+	// MaxConnIdleTime is how long any idle connection, even below
+	// MinConnections, can go unused before probe() closes it as stale.
+	// Zero disables this check; use IdleTimeout to keep the pool trimmed
+	// down to MinConnections instead
+	MaxConnIdleTime time.Duration
+	// WaitTimeout bounds how long Acquire/AcquireExclusive will block for a
+	// connection to become available. Zero means wait indefinitely, or
+	// until the passed context is cancelled
+	WaitTimeout time.Duration
+
 	// The time interval that health probes and pool size checking
 	ProbeInterval time.Duration
 	Logger        Logger
 
 	Metrics *PoolMetrics
-
-	// MinConnections uint
-	// MaxConnections uint
+	// EventMonitor, if set, receives a PoolEvent for every notable thing
+	// that happens to the pool - connection creation, checkout, checkin,
+	// removal, and probe runs
+	EventMonitor EventMonitor
 }
 
 func (p PoolOptions[T]) validate() error {
-	if p.Connect == nil {
+	if p.Endpoints != nil {
+		if p.ConnectTo == nil {
+			return ErrNoConnectFunc
+		}
+	} else if p.Connect == nil {
 		return ErrNoConnectFunc
 	}
-	if p.Connections < 1 {
-		return ErrInvalidConnectionCount
+	if p.MinConnections == 0 && p.MaxConnections == 0 {
+		if p.Connections < 1 {
+			return ErrInvalidConnectionCount
+		}
+	} else {
+		if p.MinConnections < 1 {
+			return ErrInvalidConnectionCount
+		}
+		if p.MaxConnections < p.MinConnections {
+			return ErrInvalidMaxConnections
+		}
 	}
 	if p.ProbeInterval <= 0 {
 		return ErrInvalidProbeInterval
@@ -54,14 +162,55 @@ func (p PoolOptions[T]) validate() error {
 	return nil
 }
 
+// PoolStats is a snapshot of the pool's current connection counts.
+type PoolStats struct {
+	// InUse is the number of connections currently checked out via ExNext
+	InUse int
+	// Idle is the number of connections sat in the pool, ready to be handed out
+	Idle int
+	// Total is the number of connections the pool currently holds open, idle + in-use
+	Total int
+}
+
 type Pool[T Connection] struct {
 	opts PoolOptions[T]
 
 	log Logger
 
-	pool []T
+	pool []entry[T]
 	mu   *sync.Mutex
 
+	// min and max are resolved from PoolOptions once at construction time
+	min int
+	max int
+
+	// checkedOut tracks connections handed out via ExNext/AcquireExclusive
+	// that haven't yet been returned, keyed by entry.id, so their bookkeeping
+	// (createdAt in particular) survives the round trip
+	checkedOut map[uint64]entry[T]
+	// checkedOutIndex maps a checked-out connection's identity (see connKey)
+	// to its id in checkedOut, so Return can find it from the raw T value alone
+	checkedOutIndex map[uintptr]uint64
+	// nextConnID assigns each entry its id
+	nextConnID uint64
+
+	// generation is bumped by Clear to mark every existing connection stale
+	generation uint64
+	// interrupted tracks checked-out connections (by id) that were
+	// force-closed before the caller returned them - by Clear(true), or by
+	// Healthy/endpoint removal - so Return knows to drop rather than recycle them
+	interrupted map[uint64]struct{}
+
+	// endpoints is the current candidate set from PoolOptions.Endpoints,
+	// sorted by Priority ascending. Only populated in multi-endpoint mode
+	endpoints []Endpoint
+	// endpointIdx is a round-robin cursor into endpoints
+	endpointIdx uint64
+
+	// notify is closed and replaced every time the pool's capacity changes
+	// in a way that might unblock an Acquire/AcquireExclusive waiter
+	notify chan struct{}
+
 	prober *sync.Mutex
 
 	closer *sync.Once
@@ -79,15 +228,26 @@ func New[T Connection](opts PoolOptions[T]) (*Pool[T], error) {
 		logger = DefaultLogger
 	}
 
+	min, max := opts.MinConnections, opts.MaxConnections
+	if min == 0 && max == 0 {
+		min, max = opts.Connections, opts.Connections
+	}
+
 	pool := &Pool[T]{
-		opts:   opts,
-		log:    logger,
-		pool:   []T{},
-		mu:     &sync.Mutex{},
-		prober: &sync.Mutex{},
-		closer: &sync.Once{},
-		closed: make(chan struct{}, 1),
-		next:   0,
+		opts:            opts,
+		log:             logger,
+		pool:            []entry[T]{},
+		checkedOut:      map[uint64]entry[T]{},
+		checkedOutIndex: map[uintptr]uint64{},
+		interrupted:     map[uint64]struct{}{},
+		min:             min,
+		max:             max,
+		mu:              &sync.Mutex{},
+		notify:          make(chan struct{}),
+		prober:          &sync.Mutex{},
+		closer:          &sync.Once{},
+		closed:          make(chan struct{}, 1),
+		next:            0,
 	}
 
 	pool.connect()
@@ -96,76 +256,299 @@ func New[T Connection](opts PoolOptions[T]) (*Pool[T], error) {
 }
 
 // Gets the next connection from the pool. Leaves the connection
-// in the pool available to other consumers.
+// in the pool available to other consumers. Dials a new connection, up to
+// MaxConnections, if every existing one is currently checked out.
 func (p *Pool[T]) Next() (T, bool) {
-	len := p.Size()
-	if len == 0 {
+	if conn, ok := p.peekIdle(); ok {
+		p.reportRetrieval()
+		p.emit(PoolEventCheckedOut, "", nil)
+		return conn, true
+	}
+
+	conn, ok := p.growOne()
+	if !ok {
 		var empty T
 		return empty, false
 	}
 
-	n := atomic.AddUint64(&p.next, 1)
 	p.reportRetrieval()
-
-	return p.pool[int(n)%len], true
+	p.reportStats()
+	p.emit(PoolEventCheckedOut, "", nil)
+	return conn, true
 }
 
 // Gets the next connection from the pool exclusively - i.e. it is not
-// available for other consumers to use.
+// available for other consumers to use. Dials a new connection, up to
+// MaxConnections, if every existing one is currently checked out.
 func (p *Pool[T]) ExNext() (T, bool) {
-	len := p.Size()
-	if p.Size() == 0 {
+	if conn, ok := p.takeIdle(); ok {
+		p.reportRetrieval()
+		p.reportStats()
+		p.emit(PoolEventCheckedOut, "", nil)
+		return conn, true
+	}
+
+	conn, ok := p.dialExclusive()
+	if !ok {
 		var empty T
 		return empty, false
 	}
-	n := atomic.AddUint64(&p.next, 1)
 
-	index := int(n) % len
+	p.reportRetrieval()
+	p.reportStats()
+	p.emit(PoolEventCheckedOut, "", nil)
+	return conn, true
+}
+
+// Return a ExNext connection to the pool
+func (p *Pool[T]) Return(c T) {
+	key := connKey(c)
 
 	p.mu.Lock()
-	conn := p.pool[index]
+	id, tracked := p.checkedOutIndex[key]
+	if tracked {
+		delete(p.checkedOutIndex, key)
+	}
+
+	if tracked {
+		if _, ok := p.interrupted[id]; ok {
+			// Force-closed while checked out - by Clear(true) or because
+			// its endpoint went bad - drop it instead of recycling a dead
+			// connection
+			delete(p.interrupted, id)
+			delete(p.checkedOut, id)
+			p.mu.Unlock()
+
+			p.reportStats()
+			p.wake()
+			return
+		}
+	}
+
+	it, ok := p.checkedOut[id]
+	if ok {
+		delete(p.checkedOut, id)
+	} else {
+		it = entry[T]{conn: c, createdAt: time.Now(), generation: p.generation, id: atomic.AddUint64(&p.nextConnID, 1)}
+	}
+	it.lastUsedAt = time.Now()
+	p.pool = append(p.pool, it)
 	p.mu.Unlock()
 
-	p.reportRetrieval()
+	p.reportStats()
+	p.wake()
+	p.emit(PoolEventCheckedIn, "", nil)
+}
 
-	p.removeFromPool(index)
-	return conn, true
+// Acquire blocks until a connection becomes available, ctx is cancelled, or
+// WaitTimeout elapses, whichever comes first. Unlike Next, it will wait
+// rather than fail when the pool is at MaxConnections and every connection
+// is checked out.
+func (p *Pool[T]) Acquire(ctx context.Context) (T, error) {
+	return p.acquire(ctx, false)
 }
 
-// Return a ExNext connection to the pool
-func (p *Pool[T]) Return(c T) {
+// AcquireExclusive is the blocking equivalent of ExNext - it waits for a
+// connection to become available rather than failing immediately.
+func (p *Pool[T]) AcquireExclusive(ctx context.Context) (T, error) {
+	return p.acquire(ctx, true)
+}
+
+func (p *Pool[T]) acquire(ctx context.Context, exclusive bool) (T, error) {
+	start := time.Now()
+
+	if p.opts.WaitTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.opts.WaitTimeout)
+		defer cancel()
+	}
+
+	for {
+		// Snapshot the wait channel before checking tryAcquire so a wake()
+		// from a concurrent Return that lands in between is not missed - wake()
+		// always closes the channel handed out by the last waitChan() call, so
+		// even a signal we raced with is still observed as already-closed.
+		wake := p.waitChan()
+
+		if conn, ok := p.tryAcquire(exclusive); ok {
+			p.reportWait(time.Since(start))
+			return conn, nil
+		}
+
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			p.reportTimeout()
+			var empty T
+			return empty, fmt.Errorf("%w: %w", ErrPoolTimeout, ctx.Err())
+		case <-p.Closed():
+			var empty T
+			return empty, ErrPoolClosed
+		}
+	}
+}
+
+func (p *Pool[T]) tryAcquire(exclusive bool) (T, bool) {
+	if exclusive {
+		if conn, ok := p.takeIdle(); ok {
+			p.reportRetrieval()
+			p.reportStats()
+			p.emit(PoolEventCheckedOut, "", nil)
+			return conn, true
+		}
+		if conn, ok := p.dialExclusive(); ok {
+			p.reportRetrieval()
+			p.reportStats()
+			p.emit(PoolEventCheckedOut, "", nil)
+			return conn, true
+		}
+		var empty T
+		return empty, false
+	}
+
+	if conn, ok := p.peekIdle(); ok {
+		p.reportRetrieval()
+		p.emit(PoolEventCheckedOut, "", nil)
+		return conn, true
+	}
+	if conn, ok := p.growOne(); ok {
+		p.reportRetrieval()
+		p.reportStats()
+		p.emit(PoolEventCheckedOut, "", nil)
+		return conn, true
+	}
+	var empty T
+	return empty, false
+}
+
+// waitChan returns the channel Acquire/AcquireExclusive should block on
+// until the pool's capacity next changes.
+func (p *Pool[T]) waitChan() <-chan struct{} {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	p.pool = append(p.pool, c)
+	return p.notify
+}
+
+// wake unblocks anyone waiting in Acquire/AcquireExclusive so they can
+// re-check whether a connection is now available.
+func (p *Pool[T]) wake() {
+	p.mu.Lock()
+	old := p.notify
+	p.notify = make(chan struct{})
+	p.mu.Unlock()
+	close(old)
 }
 
 func (p *Pool[T]) Closed() <-chan struct{} {
 	return p.closed
 }
 
+// Size returns the number of idle connections currently sat in the pool.
 func (p *Pool[T]) Size() int {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	return len(p.pool)
 }
 
+// Stats returns a snapshot of the pool's current connection counts.
+func (p *Pool[T]) Stats() PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	idle := len(p.pool)
+	inUse := len(p.checkedOut)
+
+	return PoolStats{
+		InUse: inUse,
+		Idle:  idle,
+		Total: idle + inUse,
+	}
+}
+
 func (p *Pool[T]) Close() {
 	p.closer.Do(func() {
 		p.log.Debugf("closing connection pool")
 		p.closed <- struct{}{}
 
-		len := p.Size()
-		for range len {
-			if err := p.pool[0].Close(); err != nil {
+		p.mu.Lock()
+		for _, it := range p.pool {
+			if err := it.conn.Close(); err != nil {
 				p.log.Errorf("failed to close connection: %v", err)
+				p.emit(PoolEventCloseFailed, "close", err)
 			}
-			p.removeFromPool(0)
 		}
+		p.pool = nil
+		p.mu.Unlock()
+
+		p.emit(PoolEventCleared, "", nil)
 	})
 }
 
+// Clear bumps the pool's generation and closes every connection tagged with
+// an older one. Idle connections are dropped immediately, and probe() will
+// dial fresh ones to replace them on its next run. If interruptInUse is
+// true, connections currently checked out via ExNext/AcquireExclusive are
+// force-closed too - holders will see errors on their next use and should
+// call Acquire/AcquireExclusive again for a fresh connection.
+//
+// This is useful when the upstream service is known bad (auth rotated, TLS
+// cert changed, primary failed over) and waiting for the next Healthy probe
+// to catch it isn't good enough.
+func (p *Pool[T]) Clear(interruptInUse bool) {
+	p.mu.Lock()
+	p.generation++
+	gen := p.generation
+
+	stale := make([]entry[T], 0, len(p.pool))
+	fresh := make([]entry[T], 0, len(p.pool))
+	for _, it := range p.pool {
+		if it.generation < gen {
+			stale = append(stale, it)
+			continue
+		}
+		fresh = append(fresh, it)
+	}
+	p.pool = fresh
+
+	var interrupted []entry[T]
+	if interruptInUse {
+		for id, it := range p.checkedOut {
+			if it.generation >= gen {
+				continue
+			}
+			interrupted = append(interrupted, it)
+			p.interrupted[id] = struct{}{}
+		}
+	}
+	p.mu.Unlock()
+
+	for _, it := range stale {
+		if err := it.conn.Close(); err != nil {
+			p.log.Errorf("failed to close cleared connection: %v", err)
+			p.emit(PoolEventCloseFailed, "cleared", err)
+		}
+		p.emit(PoolEventRemoved, "cleared", nil)
+	}
+
+	for _, it := range interrupted {
+		if err := it.conn.Close(); err != nil {
+			p.log.Errorf("failed to close interrupted connection: %v", err)
+			p.emit(PoolEventCloseFailed, "interrupt", err)
+		}
+		p.emit(PoolEventInterruption, "interrupt", nil)
+	}
+
+	p.reportStats()
+	p.wake()
+	p.emit(PoolEventCleared, "", nil)
+}
+
 func (p *Pool[T]) connect() {
-	p.log.Debugf("creating connection pool with %d connections", p.opts.Connections)
+	p.log.Debugf("creating connection pool with %d min, %d max connections", p.min, p.max)
+
+	if p.opts.Endpoints != nil {
+		p.refreshEndpoints(context.Background())
+		go p.watchEndpoints()
+	}
 
 	p.probe()
 
@@ -183,12 +566,169 @@ func (p *Pool[T]) connect() {
 	}()
 }
 
-func (p *Pool[T]) reportSize(size int) {
-	if p.opts.Metrics != nil {
-		if p.opts.Metrics.Connections != nil {
-			p.opts.Metrics.Connections.Set(float64(size))
+// dial creates a new connection, either via Connect or, in multi-endpoint
+// mode, by dialling the next endpoint in round-robin order, and reports the
+// Endpoint it used, if any.
+func (p *Pool[T]) dial() (T, Endpoint, error) {
+	if p.opts.Endpoints != nil {
+		ep, ok := p.nextEndpoint()
+		if !ok {
+			var empty T
+			return empty, Endpoint{}, ErrNoHealthyEndpoints
+		}
+		conn, err := p.opts.ConnectTo(ep)
+		return conn, ep, err
+	}
+
+	conn, err := p.opts.Connect()
+	return conn, Endpoint{}, err
+}
+
+// nextEndpoint returns the next candidate endpoint to dial. Connections are
+// spread round-robin across whichever endpoints share the lowest (most
+// preferred) Priority currently known - higher-priority tiers are only
+// dialled once every endpoint ahead of them has dropped out of the known
+// set, giving primary/replica failover rather than even load-balancing.
+func (p *Pool[T]) nextEndpoint() (Endpoint, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.endpoints) == 0 {
+		return Endpoint{}, false
+	}
+
+	best := p.endpoints[0].Priority
+	tier := make([]Endpoint, 0, len(p.endpoints))
+	for _, ep := range p.endpoints {
+		if ep.Priority != best {
+			break
+		}
+		tier = append(tier, ep)
+	}
+
+	n := atomic.AddUint64(&p.endpointIdx, 1)
+	return tier[int(n)%len(tier)], true
+}
+
+// refreshEndpoints fetches the current endpoint set from Endpoints and
+// applies it to the pool.
+func (p *Pool[T]) refreshEndpoints(ctx context.Context) {
+	endpoints, err := p.opts.Endpoints.Endpoints(ctx)
+	if err != nil {
+		p.log.Errorf("failed to list endpoints: %v", err)
+		return
+	}
+	p.applyEndpoints(endpoints)
+}
+
+// watchEndpoints subscribes to endpoint updates, if the provider supports
+// it, and applies each one as it arrives.
+func (p *Pool[T]) watchEndpoints() {
+	updates, err := p.opts.Endpoints.Watch(context.Background())
+	if err != nil {
+		p.log.Errorf("failed to watch endpoints: %v", err)
+		return
+	}
+	if updates == nil {
+		return
+	}
+
+	for {
+		select {
+		case <-p.Closed():
+			return
+		case endpoints, ok := <-updates:
+			if !ok {
+				return
+			}
+			p.applyEndpoints(endpoints)
+		}
+	}
+}
+
+// applyEndpoints replaces the pool's known endpoint set, sorted by Priority
+// ascending, and evicts every connection - idle or checked out - dialled
+// against an endpoint that's no longer present. probe() rebuilds idle ones
+// against the current set on its next run; checked-out ones are dropped by
+// Return instead of being recycled.
+func (p *Pool[T]) applyEndpoints(endpoints []Endpoint) {
+	sorted := make([]Endpoint, len(endpoints))
+	copy(sorted, endpoints)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Priority < sorted[j].Priority })
+
+	known := make(map[string]struct{}, len(sorted))
+	for _, ep := range sorted {
+		known[ep.Addr] = struct{}{}
+	}
+
+	p.mu.Lock()
+	p.endpoints = sorted
+	p.mu.Unlock()
+
+	p.evictByEndpoint(func(ep Endpoint) bool {
+		_, ok := known[ep.Addr]
+		return !ok
+	}, "endpoint-removed")
+}
+
+// evictByEndpoint closes and drops every idle connection whose endpoint
+// matches stale, and marks any checked-out connection matching it as
+// interrupted so Return drops it instead of recycling it. Connections
+// dialled without an endpoint (single-backend mode) are never matched.
+func (p *Pool[T]) evictByEndpoint(stale func(Endpoint) bool, reason string) {
+	p.mu.Lock()
+	removed := make([]entry[T], 0)
+	fresh := make([]entry[T], 0, len(p.pool))
+	for _, it := range p.pool {
+		if it.endpoint.Addr != "" && stale(it.endpoint) {
+			removed = append(removed, it)
+			continue
+		}
+		fresh = append(fresh, it)
+	}
+	p.pool = fresh
+
+	var interrupted []entry[T]
+	for id, it := range p.checkedOut {
+		if it.endpoint.Addr == "" || !stale(it.endpoint) {
+			continue
+		}
+		interrupted = append(interrupted, it)
+		p.interrupted[id] = struct{}{}
+	}
+	p.mu.Unlock()
+
+	for _, it := range removed {
+		if err := it.conn.Close(); err != nil {
+			p.log.Errorf("failed to close connection for removed endpoint: %v", err)
+			p.emit(PoolEventCloseFailed, reason, err)
 		}
+		p.emit(PoolEventRemoved, reason, nil)
+	}
+	for _, it := range interrupted {
+		if err := it.conn.Close(); err != nil {
+			p.log.Errorf("failed to close interrupted connection: %v", err)
+			p.emit(PoolEventCloseFailed, reason, err)
+		}
+		p.emit(PoolEventInterruption, reason, nil)
+	}
+
+	if len(removed) > 0 || len(interrupted) > 0 {
+		p.reportStats()
+		p.wake()
+	}
+}
+
+// emit delivers a PoolEvent to the configured EventMonitor, if any.
+func (p *Pool[T]) emit(t PoolEventType, reason string, err error) {
+	if p.opts.EventMonitor == nil {
+		return
 	}
+	p.opts.EventMonitor.Event(PoolEvent{
+		Type:      t,
+		Reason:    reason,
+		Err:       err,
+		Timestamp: time.Now(),
+	})
 }
 
 func (p *Pool[T]) reportError() {
@@ -207,6 +747,44 @@ func (p *Pool[T]) reportRetrieval() {
 	}
 }
 
+func (p *Pool[T]) reportWait(d time.Duration) {
+	if p.opts.Metrics != nil && p.opts.Metrics.WaitDurationSeconds != nil {
+		p.opts.Metrics.WaitDurationSeconds.Observe(d.Seconds())
+	}
+}
+
+func (p *Pool[T]) reportStaleClosure() {
+	if p.opts.Metrics != nil && p.opts.Metrics.StaleClosures != nil {
+		p.opts.Metrics.StaleClosures.Inc()
+	}
+}
+
+func (p *Pool[T]) reportTimeout() {
+	if p.opts.Metrics != nil && p.opts.Metrics.WaitTimeouts != nil {
+		p.opts.Metrics.WaitTimeouts.Inc()
+	}
+}
+
+func (p *Pool[T]) reportStats() {
+	if p.opts.Metrics == nil {
+		return
+	}
+
+	stats := p.Stats()
+	if p.opts.Metrics.Connections != nil {
+		p.opts.Metrics.Connections.Set(float64(stats.Total))
+	}
+	if p.opts.Metrics.Total != nil {
+		p.opts.Metrics.Total.Set(float64(stats.Total))
+	}
+	if p.opts.Metrics.Idle != nil {
+		p.opts.Metrics.Idle.Set(float64(stats.Idle))
+	}
+	if p.opts.Metrics.InUse != nil {
+		p.opts.Metrics.InUse.Set(float64(stats.InUse))
+	}
+}
+
 func (p *Pool[T]) probe() {
 	p.prober.Lock()
 	defer p.prober.Unlock()
@@ -216,44 +794,271 @@ func (p *Pool[T]) probe() {
 	case <-p.Closed():
 		return
 	default:
-		len := p.Size()
-		p.reportSize(len)
-		if len == p.opts.Connections {
-			return
+	}
+
+	p.emit(PoolEventProbeStart, "", nil)
+
+	p.removeUnhealthy()
+	p.removeStale()
+	p.removeIdleOverflow()
+	p.fillToMin()
+
+	p.reportStats()
+	p.wake()
+	p.emit(PoolEventProbeEnd, "", nil)
+}
+
+// removeUnhealthy closes and drops any idle connection that fails the
+// configured Healthy check. In multi-endpoint mode, a failure is treated as
+// the endpoint itself going bad: every other connection to it, idle or
+// checked out, is evicted too. The endpoint stays in the known set, so
+// fillToMin can dial it again on its next run.
+func (p *Pool[T]) removeUnhealthy() {
+	if p.opts.Healthy == nil {
+		return
+	}
+
+	p.mu.Lock()
+	badEndpoints := map[string]struct{}{}
+	removed := 0
+	size := len(p.pool)
+	for i := range size {
+		idx := i - removed
+		it := p.pool[idx]
+		if p.opts.Healthy(it.conn) {
+			continue
+		}
+		if it.endpoint.Addr != "" {
+			badEndpoints[it.endpoint.Addr] = struct{}{}
 		}
+		if err := it.conn.Close(); err != nil {
+			p.log.Errorf("failed to close connection: %v", err)
+			p.emit(PoolEventCloseFailed, "unhealthy", err)
+		}
+		p.log.Errorf("removing unhealthy connection from pool")
+		p.reportError()
+		p.pool = append(p.pool[:idx], p.pool[idx+1:]...)
+		p.emit(PoolEventRemoved, "unhealthy", nil)
+		removed++
+	}
+	p.mu.Unlock()
 
-		// Check the health of all connections in the pool
-		if p.opts.Healthy != nil {
-			removed := 0
-			for i := range len {
-				if !p.opts.Healthy(p.pool[i-removed]) {
-					if err := p.pool[i-removed].Close(); err != nil {
-						p.log.Errorf("failed to close connection: %v", err)
-					}
-					p.log.Errorf("removing unhealthy connection from pool")
-					p.reportError()
-					p.removeFromPool(i - removed)
-					removed++
-				}
-			}
+	if len(badEndpoints) > 0 {
+		p.evictByEndpoint(func(ep Endpoint) bool {
+			_, ok := badEndpoints[ep.Addr]
+			return ok
+		}, "unhealthy")
+	}
+}
+
+// removeStale closes and drops any idle connection that has exceeded
+// MaxConnLifetime or MaxConnIdleTime, regardless of MinConnections -
+// fillToMin replaces whatever it removes.
+func (p *Pool[T]) removeStale() {
+	if p.opts.MaxConnLifetime <= 0 && p.opts.MaxConnIdleTime <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	removed := 0
+	size := len(p.pool)
+	for i := range size {
+		idx := i - removed
+		it := p.pool[idx]
+		stale := p.opts.MaxConnLifetime > 0 && now.Sub(it.createdAt) >= p.opts.MaxConnLifetime
+		stale = stale || (p.opts.MaxConnIdleTime > 0 && now.Sub(it.lastUsedAt) >= p.opts.MaxConnIdleTime)
+		if !stale {
+			continue
 		}
+		reason := "stale"
+		if err := it.conn.Close(); err != nil {
+			p.log.Errorf("failed to close stale connection: %v", err)
+			p.emit(PoolEventCloseFailed, reason, err)
+		}
+		p.reportStaleClosure()
+		p.pool = append(p.pool[:idx], p.pool[idx+1:]...)
+		p.emit(PoolEventRemoved, reason, nil)
+		removed++
+	}
+}
 
-		// Add in any missing connections to the pool
-		diff := p.opts.Connections - len
-		for range diff {
-			conn, err := p.opts.Connect()
-			if err != nil {
-				p.log.Errorf("failed to create connection: %v", err)
-				p.reportError()
-				continue
-			}
-			p.Return(conn)
+// removeIdleOverflow closes idle connections above MinConnections that have
+// been sat unused for longer than IdleTimeout.
+func (p *Pool[T]) removeIdleOverflow() {
+	if p.opts.IdleTimeout <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	removed := 0
+	size := len(p.pool)
+	for i := range size {
+		if size-removed <= p.min {
+			break
+		}
+		idx := i - removed
+		it := p.pool[idx]
+		if now.Sub(it.lastUsedAt) < p.opts.IdleTimeout {
+			continue
+		}
+		if err := it.conn.Close(); err != nil {
+			p.log.Errorf("failed to close idle connection: %v", err)
+			p.emit(PoolEventCloseFailed, "idle", err)
+		}
+		p.pool = append(p.pool[:idx], p.pool[idx+1:]...)
+		p.emit(PoolEventRemoved, "idle", nil)
+		removed++
+	}
+}
+
+// fillToMin dials new connections until the pool's total (idle + in-use)
+// reaches MinConnections.
+func (p *Pool[T]) fillToMin() {
+	p.mu.Lock()
+	diff := p.min - p.totalLocked()
+	p.mu.Unlock()
+
+	for range max(diff, 0) {
+		conn, ep, err := p.dial()
+		if err != nil {
+			p.log.Errorf("failed to create connection: %v", err)
+			p.reportError()
+			p.emit(PoolEventConnectFailed, "", err)
+			continue
 		}
+		p.addIdle(conn, ep)
 	}
 }
 
-func (p *Pool[T]) removeFromPool(index int) {
+// peekIdle returns the next idle connection without removing it from the pool.
+func (p *Pool[T]) peekIdle() (T, bool) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	if len(p.pool) == 0 {
+		var empty T
+		return empty, false
+	}
+	n := atomic.AddUint64(&p.next, 1)
+	index := int(n) % len(p.pool)
+	p.pool[index].lastUsedAt = time.Now()
+	return p.pool[index].conn, true
+}
+
+// takeIdle removes the next idle connection from the pool and marks it checked out.
+func (p *Pool[T]) takeIdle() (T, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.pool) == 0 {
+		var empty T
+		return empty, false
+	}
+	n := atomic.AddUint64(&p.next, 1)
+	index := int(n) % len(p.pool)
+	it := p.pool[index]
+	it.lastUsedAt = time.Now()
 	p.pool = append(p.pool[:index], p.pool[index+1:]...)
+	p.checkedOut[it.id] = it
+	p.checkedOutIndex[connKey(it.conn)] = it.id
+	return it.conn, true
+}
+
+// growOne dials a new connection and adds it to the idle pool, as long as
+// doing so doesn't exceed MaxConnections.
+func (p *Pool[T]) growOne() (T, bool) {
+	p.mu.Lock()
+	if p.totalLocked() >= p.max {
+		p.mu.Unlock()
+		var empty T
+		return empty, false
+	}
+	p.mu.Unlock()
+
+	conn, ep, err := p.dial()
+	if err != nil {
+		p.log.Errorf("failed to create connection: %v", err)
+		p.reportError()
+		p.emit(PoolEventConnectFailed, "", err)
+		var empty T
+		return empty, false
+	}
+
+	p.mu.Lock()
+	if p.totalLocked() >= p.max {
+		p.mu.Unlock()
+		if err := conn.Close(); err != nil {
+			p.log.Errorf("failed to close connection: %v", err)
+			p.emit(PoolEventCloseFailed, "", err)
+		}
+		var empty T
+		return empty, false
+	}
+	now := time.Now()
+	p.pool = append(p.pool, entry[T]{conn: conn, createdAt: now, lastUsedAt: now, generation: p.generation, endpoint: ep, id: atomic.AddUint64(&p.nextConnID, 1)})
+	p.mu.Unlock()
+	p.emit(PoolEventCreated, "", nil)
+
+	return conn, true
+}
+
+// dialExclusive dials a new connection and marks it checked out without
+// ever putting it in the idle pool, as long as doing so doesn't exceed
+// MaxConnections.
+func (p *Pool[T]) dialExclusive() (T, bool) {
+	p.mu.Lock()
+	if p.totalLocked() >= p.max {
+		p.mu.Unlock()
+		var empty T
+		return empty, false
+	}
+	p.mu.Unlock()
+
+	conn, ep, err := p.dial()
+	if err != nil {
+		p.log.Errorf("failed to create connection: %v", err)
+		p.reportError()
+		p.emit(PoolEventConnectFailed, "", err)
+		var empty T
+		return empty, false
+	}
+
+	p.mu.Lock()
+	if p.totalLocked() >= p.max {
+		p.mu.Unlock()
+		if err := conn.Close(); err != nil {
+			p.log.Errorf("failed to close connection: %v", err)
+			p.emit(PoolEventCloseFailed, "", err)
+		}
+		var empty T
+		return empty, false
+	}
+	now := time.Now()
+	id := atomic.AddUint64(&p.nextConnID, 1)
+	p.checkedOut[id] = entry[T]{conn: conn, createdAt: now, lastUsedAt: now, generation: p.generation, endpoint: ep, id: id}
+	p.checkedOutIndex[connKey(conn)] = id
+	p.mu.Unlock()
+	p.emit(PoolEventCreated, "", nil)
+
+	return conn, true
+}
+
+// addIdle adds a freshly dialled connection straight to the idle pool. Used
+// by fillToMin, as opposed to Return which checks an existing connection
+// back in after ExNext/AcquireExclusive.
+func (p *Pool[T]) addIdle(conn T, ep Endpoint) {
+	p.mu.Lock()
+	now := time.Now()
+	p.pool = append(p.pool, entry[T]{conn: conn, createdAt: now, lastUsedAt: now, generation: p.generation, endpoint: ep, id: atomic.AddUint64(&p.nextConnID, 1)})
+	p.mu.Unlock()
+	p.emit(PoolEventCreated, "", nil)
+}
+
+// totalLocked returns idle + checked-out connections. Callers must hold p.mu.
+func (p *Pool[T]) totalLocked() int {
+	return len(p.pool) + len(p.checkedOut)
 }
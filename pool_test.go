@@ -1,7 +1,11 @@
 package pool
 
 import (
+	"context"
+	"errors"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -96,7 +100,7 @@ func TestItInitsPool(t *testing.T) {
 
 	for _, c := range tcs {
 		t.Run(c.name, func(t *testing.T) {
-			pool, err := NewPool(PoolOptions[*dummyConn]{
+			pool, err := New(PoolOptions[*dummyConn]{
 				Connect:       c.connect,
 				Healthy:       c.healthy,
 				Connections:   c.connections,
@@ -111,7 +115,7 @@ func TestItInitsPool(t *testing.T) {
 }
 
 func TestItReturnsAConnection(t *testing.T) {
-	pool, err := NewPool(PoolOptions[*dummyConn]{
+	pool, err := New(PoolOptions[*dummyConn]{
 		Connect: func() (*dummyConn, error) {
 			return newDummy(false), nil
 		},
@@ -133,7 +137,7 @@ func TestItReturnsAConnection(t *testing.T) {
 }
 
 func TestItExlusivelyReturnsAConnection(t *testing.T) {
-	pool, err := NewPool(PoolOptions[*dummyConn]{
+	pool, err := New(PoolOptions[*dummyConn]{
 		Connect: func() (*dummyConn, error) {
 			return newDummy(false), nil
 		},
@@ -156,6 +160,456 @@ func TestItExlusivelyReturnsAConnection(t *testing.T) {
 	require.Equal(t, 1, pool.Size())
 }
 
+func TestPoolGrowsOnDemandAboveMinUpToMax(t *testing.T) {
+	var created atomic.Int32
+	pool, err := New(PoolOptions[*dummyConn]{
+		Connect: func() (*dummyConn, error) {
+			created.Add(1)
+			return newDummy(false), nil
+		},
+		MinConnections: 1,
+		MaxConnections: 3,
+		ProbeInterval:  time.Millisecond,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return created.Load() >= 1
+	}, 100*time.Millisecond, time.Millisecond)
+
+	// Exhaust the single min connection - ExNext should dial on demand rather
+	// than fail, up to MaxConnections
+	first, ok := pool.ExNext()
+	require.True(t, ok)
+	second, ok := pool.ExNext()
+	require.True(t, ok)
+	third, ok := pool.ExNext()
+	require.True(t, ok)
+
+	require.Equal(t, int32(3), created.Load())
+	require.Equal(t, 3, pool.Stats().InUse)
+
+	// MaxConnections is now exhausted - Next should fail rather than grow further
+	_, ok = pool.Next()
+	require.False(t, ok)
+
+	pool.Return(first)
+	pool.Return(second)
+	pool.Return(third)
+}
+
+func TestProbeReapsIdleConnectionsAboveMinAfterIdleTimeout(t *testing.T) {
+	pool, err := New(PoolOptions[*dummyConn]{
+		Connect: func() (*dummyConn, error) {
+			return newDummy(false), nil
+		},
+		MinConnections: 1,
+		MaxConnections: 3,
+		IdleTimeout:    5 * time.Millisecond,
+		ProbeInterval:  time.Millisecond,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return pool.Size() == 1
+	}, 100*time.Millisecond, time.Millisecond)
+
+	// Grow the pool above min by checking out every connection, including
+	// freshly dialled ones, then return them all so they sit idle
+	one, ok := pool.ExNext()
+	require.True(t, ok)
+	two, ok := pool.ExNext()
+	require.True(t, ok)
+	three, ok := pool.ExNext()
+	require.True(t, ok)
+	pool.Return(one)
+	pool.Return(two)
+	pool.Return(three)
+
+	require.Eventually(t, func() bool {
+		return pool.Size() == 3
+	}, 100*time.Millisecond, time.Millisecond)
+
+	// probe() should trim idle connections above MinConnections once they've
+	// been idle for longer than IdleTimeout
+	require.Eventually(t, func() bool {
+		return pool.Size() == 1
+	}, 200*time.Millisecond, time.Millisecond)
+}
+
+func TestAcquireWaitsForAReturnedConnection(t *testing.T) {
+	pool, err := New(PoolOptions[*dummyConn]{
+		Connect: func() (*dummyConn, error) {
+			return newDummy(false), nil
+		},
+		MinConnections: 1,
+		MaxConnections: 1,
+		ProbeInterval:  time.Millisecond,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	// Wait for it to connect
+	time.Sleep(time.Millisecond)
+
+	conn, ok := pool.ExNext()
+	require.True(t, ok)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		pool.Return(conn)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	acquired, err := pool.AcquireExclusive(ctx)
+	require.Nil(t, err)
+	requireConn(t, acquired)
+}
+
+func TestAcquireTimesOutWhenPoolIsExhausted(t *testing.T) {
+	pool, err := New(PoolOptions[*dummyConn]{
+		Connect: func() (*dummyConn, error) {
+			return newDummy(false), nil
+		},
+		MinConnections: 1,
+		MaxConnections: 1,
+		ProbeInterval:  time.Millisecond,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	// Wait for it to connect
+	time.Sleep(time.Millisecond)
+
+	_, ok := pool.ExNext()
+	require.True(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	_, err = pool.AcquireExclusive(ctx)
+	require.ErrorIs(t, err, ErrPoolTimeout)
+}
+
+func TestAcquireWakesImmediatelyOnReturnWithoutWaitingForProbe(t *testing.T) {
+	pool, err := New(PoolOptions[*dummyConn]{
+		Connect: func() (*dummyConn, error) {
+			return newDummy(false), nil
+		},
+		MinConnections: 1,
+		MaxConnections: 1,
+		ProbeInterval:  time.Hour,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	// Wait for it to connect
+	time.Sleep(time.Millisecond)
+
+	conn, ok := pool.ExNext()
+	require.True(t, ok)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		pool.Return(conn)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	acquired, err := pool.AcquireExclusive(ctx)
+	require.Nil(t, err)
+	requireConn(t, acquired)
+}
+
+func TestProbeClosesConnectionsPastMaxLifetime(t *testing.T) {
+	var created atomic.Int32
+	pool, err := New(PoolOptions[*dummyConn]{
+		Connect: func() (*dummyConn, error) {
+			created.Add(1)
+			return newDummy(false), nil
+		},
+		MinConnections:  1,
+		MaxConnections:  1,
+		MaxConnLifetime: 5 * time.Millisecond,
+		ProbeInterval:   time.Millisecond,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return created.Load() >= 2
+	}, 100*time.Millisecond, time.Millisecond)
+}
+
+type recordingMonitor struct {
+	mu     sync.Mutex
+	events []PoolEvent
+}
+
+func (r *recordingMonitor) Event(e PoolEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *recordingMonitor) has(t PoolEventType) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.events {
+		if e.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+func TestEventMonitorReceivesCheckoutAndCheckinEvents(t *testing.T) {
+	monitor := &recordingMonitor{}
+	pool, err := New(PoolOptions[*dummyConn]{
+		Connect: func() (*dummyConn, error) {
+			return newDummy(false), nil
+		},
+		Connections:   1,
+		ProbeInterval: time.Millisecond,
+		EventMonitor:  monitor,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return monitor.has(PoolEventCreated)
+	}, 100*time.Millisecond, time.Millisecond)
+
+	conn, ok := pool.ExNext()
+	require.True(t, ok)
+	require.True(t, monitor.has(PoolEventCheckedOut))
+
+	pool.Return(conn)
+	require.True(t, monitor.has(PoolEventCheckedIn))
+}
+
+func TestEventMonitorReceivesConnectFailedEvents(t *testing.T) {
+	monitor := &recordingMonitor{}
+	pool, err := New(PoolOptions[*dummyConn]{
+		Connect: func() (*dummyConn, error) {
+			return nil, errors.New("dial failed")
+		},
+		Connections:   1,
+		ProbeInterval: time.Millisecond,
+		EventMonitor:  monitor,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return monitor.has(PoolEventConnectFailed)
+	}, 100*time.Millisecond, time.Millisecond)
+}
+
+func TestClearReplacesIdleConnections(t *testing.T) {
+	var created atomic.Int32
+	pool, err := New(PoolOptions[*dummyConn]{
+		Connect: func() (*dummyConn, error) {
+			created.Add(1)
+			return newDummy(false), nil
+		},
+		MinConnections: 1,
+		MaxConnections: 1,
+		ProbeInterval:  time.Millisecond,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return created.Load() >= 1
+	}, 100*time.Millisecond, time.Millisecond)
+
+	pool.Clear(false)
+
+	require.Eventually(t, func() bool {
+		return created.Load() >= 2
+	}, 100*time.Millisecond, time.Millisecond)
+}
+
+func TestClearInterruptsCheckedOutConnections(t *testing.T) {
+	pool, err := New(PoolOptions[*dummyConn]{
+		Connect: func() (*dummyConn, error) {
+			return newDummy(false), nil
+		},
+		MinConnections: 1,
+		MaxConnections: 1,
+		ProbeInterval:  time.Millisecond,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return pool.Size() == 1
+	}, 100*time.Millisecond, time.Millisecond)
+
+	conn, ok := pool.ExNext()
+	require.True(t, ok)
+
+	pool.Clear(true)
+
+	pool.Return(conn)
+	require.Equal(t, 0, pool.Size())
+}
+
+func TestPoolSpreadsConnectionsAcrossEndpoints(t *testing.T) {
+	pool, err := New(PoolOptions[*dummyConn]{
+		Endpoints: StaticEndpoints([]Endpoint{
+			{Addr: "a:1", Priority: 0},
+			{Addr: "b:1", Priority: 0},
+		}),
+		ConnectTo: func(ep Endpoint) (*dummyConn, error) {
+			return newDummy(false), nil
+		},
+		MinConnections: 2,
+		MaxConnections: 2,
+		ProbeInterval:  time.Millisecond,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return pool.Size() == 2
+	}, 100*time.Millisecond, time.Millisecond)
+}
+
+func TestPoolPrefersLowestPriorityEndpoints(t *testing.T) {
+	var dialedPrimary, dialedBackup atomic.Int32
+	pool, err := New(PoolOptions[*dummyConn]{
+		Endpoints: StaticEndpoints([]Endpoint{
+			{Addr: "primary:1", Priority: 0},
+			{Addr: "backup:1", Priority: 10},
+		}),
+		ConnectTo: func(ep Endpoint) (*dummyConn, error) {
+			if ep.Addr == "backup:1" {
+				dialedBackup.Add(1)
+			} else {
+				dialedPrimary.Add(1)
+			}
+			return newDummy(false), nil
+		},
+		MinConnections: 3,
+		MaxConnections: 3,
+		ProbeInterval:  time.Millisecond,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return pool.Size() == 3
+	}, 100*time.Millisecond, time.Millisecond)
+
+	require.True(t, dialedPrimary.Load() > 0)
+	require.Equal(t, int32(0), dialedBackup.Load())
+}
+
+func TestPoolInterruptsCheckedOutConnectionForRemovedEndpoint(t *testing.T) {
+	updates := make(chan []Endpoint, 1)
+	provider := &fakeEndpointProvider{
+		endpoints: []Endpoint{
+			{Addr: "a:1", Priority: 0},
+			{Addr: "b:1", Priority: 0},
+		},
+		updates: updates,
+	}
+	monitor := &recordingMonitor{}
+
+	pool, err := New(PoolOptions[*dummyConn]{
+		Endpoints: provider,
+		ConnectTo: func(ep Endpoint) (*dummyConn, error) {
+			return newDummy(false), nil
+		},
+		MinConnections: 2,
+		MaxConnections: 2,
+		ProbeInterval:  time.Millisecond,
+		EventMonitor:   monitor,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return pool.Size() == 2
+	}, 100*time.Millisecond, time.Millisecond)
+
+	conn, ok := pool.ExNext()
+	require.True(t, ok)
+
+	// Drop both endpoints so whichever one conn belongs to is gone - the
+	// pool should mark it interrupted rather than recycle it on Return
+	updates <- []Endpoint{}
+
+	require.Eventually(t, func() bool {
+		return monitor.has(PoolEventInterruption)
+	}, 100*time.Millisecond, time.Millisecond)
+
+	pool.Return(conn)
+	require.Equal(t, 0, pool.Stats().InUse)
+}
+
+func TestPoolRebuildsConnectionsForRemovedEndpoint(t *testing.T) {
+	var dialedA, dialedB atomic.Int32
+	updates := make(chan []Endpoint, 1)
+	provider := &fakeEndpointProvider{
+		endpoints: []Endpoint{
+			{Addr: "a:1", Priority: 0},
+			{Addr: "b:1", Priority: 0},
+		},
+		updates: updates,
+	}
+
+	pool, err := New(PoolOptions[*dummyConn]{
+		Endpoints: provider,
+		ConnectTo: func(ep Endpoint) (*dummyConn, error) {
+			if ep.Addr == "b:1" {
+				dialedB.Add(1)
+			} else {
+				dialedA.Add(1)
+			}
+			return newDummy(false), nil
+		},
+		MinConnections: 2,
+		MaxConnections: 2,
+		ProbeInterval:  time.Millisecond,
+	})
+	require.Nil(t, err)
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		return pool.Size() == 2
+	}, 100*time.Millisecond, time.Millisecond)
+	require.True(t, dialedB.Load() > 0)
+
+	// Removing b:1 should drop its connection from the idle pool and leave
+	// fillToMin to replace it with a fresh dial against a:1
+	updates <- []Endpoint{{Addr: "a:1", Priority: 0}}
+
+	require.Eventually(t, func() bool {
+		return dialedA.Load() >= 2 && pool.Size() == 2
+	}, 100*time.Millisecond, time.Millisecond)
+}
+
+type fakeEndpointProvider struct {
+	endpoints []Endpoint
+	updates   chan []Endpoint
+}
+
+func (f *fakeEndpointProvider) Endpoints(ctx context.Context) ([]Endpoint, error) {
+	return f.endpoints, nil
+}
+
+func (f *fakeEndpointProvider) Watch(ctx context.Context) (<-chan []Endpoint, error) {
+	return f.updates, nil
+}
+
 func requireConn(t *testing.T, c *dummyConn) {
 	require.NotNil(t, c)
 	require.False(t, reflect.DeepEqual(*c, dummyConn{}))